@@ -69,6 +69,14 @@ type Flow struct {
 	// the login and consent UIs.
 	HydraLoginRequest *hydraclientgo.OAuth2LoginRequest `json:"oauth2_login_request,omitempty" faker:"-" db:"-"`
 
+	// HydraLoginSessionID is the Hydra login session ID associated with this login flow.
+	//
+	// It is derived from HydraLoginRequest.SessionId (see BeforeSave) whenever the Hydra
+	// login request has been populated, and is persisted in the hydra_login_session_id_data
+	// column. It is not part of the flow's JSON, and nothing looks up or revokes Kratos
+	// sessions by this ID.
+	HydraLoginSessionID sqlxx.NullString `json:"-" faker:"-" db:"hydra_login_session_id_data"`
+
 	// Type represents the flow's type which can be either "api" or "browser", depending on the flow interaction.
 	//
 	// required: true
@@ -288,6 +296,16 @@ func (f *Flow) AfterSave(*pop.Connection) error {
 	return nil
 }
 
+// BeforeSave derives HydraLoginSessionID from HydraLoginRequest (when the latter has
+// been populated from Hydra) so that the Hydra login session ID is persisted alongside
+// the flow without every caller having to remember to set it explicitly.
+func (f *Flow) BeforeSave(*pop.Connection) error {
+	if f.HydraLoginRequest != nil && f.HydraLoginRequest.SessionId != nil {
+		f.HydraLoginSessionID = sqlxx.NullString(*f.HydraLoginRequest.SessionId)
+	}
+	return nil
+}
+
 func (f *Flow) GetUI() *container.Container {
 	return f.UI
 }
@@ -352,3 +370,7 @@ func (f *Flow) ToLoggerField() map[string]interface{} {
 func (f *Flow) GetOAuth2LoginChallenge() sqlxx.NullString {
 	return f.OAuth2LoginChallenge
 }
+
+func (f *Flow) GetHydraLoginSessionID() sqlxx.NullString {
+	return f.HydraLoginSessionID
+}
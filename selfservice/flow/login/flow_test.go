@@ -0,0 +1,35 @@
+// Copyright © 2023 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package login_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hydraclientgo "github.com/ory/hydra-client-go/v2"
+
+	"github.com/ory/kratos/selfservice/flow/login"
+)
+
+func TestFlowHydraLoginSessionID(t *testing.T) {
+	t.Run("is derived from the Hydra login request on save", func(t *testing.T) {
+		sessionID := "hydra-login-session-id"
+		f := &login.Flow{
+			HydraLoginRequest: &hydraclientgo.OAuth2LoginRequest{SessionId: &sessionID},
+		}
+
+		require.NoError(t, f.BeforeSave(nil))
+		assert.NotEmpty(t, f.GetHydraLoginSessionID())
+		assert.Equal(t, sessionID, f.GetHydraLoginSessionID().String())
+	})
+
+	t.Run("is left unset when there is no Hydra login request", func(t *testing.T) {
+		f := &login.Flow{}
+
+		require.NoError(t, f.BeforeSave(nil))
+		assert.Empty(t, f.GetHydraLoginSessionID())
+	})
+}